@@ -0,0 +1,90 @@
+// Command assistant-server runs the chat+tools loop as a long-running
+// gRPC service instead of a one-shot CLI invocation.
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+	"github.com/sog01/go-openai-example/pkg/memory"
+	"github.com/sog01/go-openai-example/pkg/server"
+	"github.com/sog01/go-openai-example/pkg/tools"
+	assistantpb "github.com/sog01/go-openai-example/proto"
+)
+
+const defaultTokenBudget = 3000
+
+func main() {
+	godotenv.Load(".env")
+
+	registry := tools.NewRegistry()
+	if err := registry.Register(tools.NewGeocodeTool()); err != nil {
+		log.Fatalf("failed to register geocode tool: %v", err)
+	}
+	if err := registry.Register(tools.NewWeatherTool(os.Getenv("OPENWEATHERMAP_API_KEY"))); err != nil {
+		log.Fatalf("failed to register weather tool: %v", err)
+	}
+	if err := registry.Register(tools.NewForecastTool(os.Getenv("OPENWEATHERMAP_API_KEY"))); err != nil {
+		log.Fatalf("failed to register weather_forecast tool: %v", err)
+	}
+
+	var store memory.ConversationStore
+	if dbPath := os.Getenv("CONVERSATION_DB_PATH"); dbPath != "" {
+		boltStore, err := memory.NewBoltStore(dbPath)
+		if err != nil {
+			log.Fatalf("failed to open conversation store: %v", err)
+		}
+		store = boltStore
+	} else {
+		store = memory.NewInMemoryStore()
+	}
+
+	tokenBudget := defaultTokenBudget
+	if v := os.Getenv("CONVERSATION_TOKEN_BUDGET"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid CONVERSATION_TOKEN_BUDGET %q: %v", v, err)
+		}
+		tokenBudget = parsed
+	}
+	budget, err := memory.NewBudget(tokenBudget)
+	if err != nil {
+		log.Fatalf("failed to configure conversation budget: %v", err)
+	}
+
+	summaryModel := os.Getenv("SUMMARY_MODEL")
+	if summaryModel == "" {
+		summaryModel = "gpt-3.5-turbo"
+	}
+	summarizer := memory.NewSummarizer(llm.NewOpenAIBackend(os.Getenv("OPENAI_API_KEY"), "", summaryModel), summaryModel)
+
+	backend, err := llm.NewFromEnv(context.Background())
+	if err != nil {
+		log.Fatalf("failed to configure LLM backend: %v", err)
+	}
+
+	addr := os.Getenv("ASSISTANT_GRPC_ADDR")
+	if addr == "" {
+		addr = ":50051"
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", addr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	assistantpb.RegisterAssistantServer(grpcServer, server.New(backend, registry, store, budget, summarizer))
+
+	log.Printf("assistant gRPC server listening on %s", addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
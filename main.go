@@ -3,186 +3,340 @@ package main
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/joho/godotenv"
-	op "github.com/sashabaranov/go-openai"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+	"github.com/sog01/go-openai-example/pkg/memory"
+	"github.com/sog01/go-openai-example/pkg/tools"
 )
 
 // https://platform.openai.com/docs/api-reference/chat
 
+const defaultTokenBudget = 3000
+
+// maxTurns bounds how many times converse/converseStream can recurse
+// chasing tool_calls in a single query, so a model that never stops
+// requesting tools can't recurse forever.
+const maxTurns = 13
+
 var (
-	openWeatherMapAPIKEY string
-	openAIAPIKEY         string
+	registry = tools.NewRegistry()
+	backend  llm.Backend
+	store    memory.ConversationStore
+	budget   *memory.Budget
+	summary  *memory.Summarizer
 )
 
-func geocode(location string) ([]byte, error) {
-	url := fmt.Sprintf("https://geocoding-api.open-meteo.com/v1/search?name=%s&count=1&format=json", location)
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, err
+func toolDefinitions() []llm.ToolDefinition {
+	var defs []llm.ToolDefinition
+	for _, p := range registry.List() {
+		defs = append(defs, llm.ToolDefinition{
+			Name:        p.Name(),
+			Description: p.Description(),
+			Parameters:  p.ParametersSchema(),
+		})
 	}
-	defer resp.Body.Close()
+	return defs
+}
 
-	return ioutil.ReadAll(resp.Body)
+// dispatchToolCalls executes every tool call the model asked for in one
+// assistant turn concurrently, returning a `tool` role message per call
+// indexed the same order they were requested in.
+func dispatchToolCalls(ctx context.Context, toolCalls []llm.ToolCall) []llm.Message {
+	results := make([]llm.Message, len(toolCalls))
+
+	var wg sync.WaitGroup
+	for i, tc := range toolCalls {
+		wg.Add(1)
+		go func(i int, tc llm.ToolCall) {
+			defer wg.Done()
+
+			args := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(tc.Arguments), &args); err != nil {
+				results[i] = llm.Message{Role: "tool", Content: fmt.Sprintf("error: %v", err), Name: tc.Name, ToolCallID: tc.ID}
+				return
+			}
+
+			fmt.Println("DEBUG invoke function ", tc.Name, args)
+
+			content, err := registry.Invoke(ctx, tc.Name, args)
+			if err != nil {
+				content = []byte(fmt.Sprintf("error: %v", err))
+			}
+
+			results[i] = llm.Message{
+				Role:       "tool",
+				Content:    string(content),
+				Name:       tc.Name,
+				ToolCallID: tc.ID,
+			}
+		}(i, tc)
+	}
+	wg.Wait()
+
+	return results
 }
 
-func weather(lat, lon string) ([]byte, error) {
-	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/weather?units=metric&lat=$%s&lon=%s&appid=%s", lat, lon, openWeatherMapAPIKEY)
-	resp, err := http.Get(url)
+// converse drives one chat turn, persisting the conversation to store
+// under sessionID after every assistant turn (including intermediate
+// tool round-trips) so a crash can be resumed from the last saved state.
+// It recurses for each tool round-trip up to maxTurns deep so a model
+// that never stops requesting tools can't recurse forever.
+func converse(ctx context.Context, sessionID string, messages []llm.Message, turn int) (string, error) {
+	if turn >= maxTurns {
+		return "", fmt.Errorf("exceeded max turns (%d) chasing tool calls", maxTurns)
+	}
+
+	messages, err := memory.Condense(ctx, budget, summary, messages)
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("failed to condense conversation: %v", err)
 	}
-	defer resp.Body.Close()
 
-	return ioutil.ReadAll(resp.Body)
-}
+	resp, err := backend.Chat(ctx, llm.Request{Messages: messages, Tools: toolDefinitions()})
+	if err != nil {
+		return "", fmt.Errorf("failed chat: %v", err)
+	}
 
-func chat(messages []op.ChatCompletionMessage) (op.ChatCompletionResponse, error) {
-	client := op.NewClient(openAIAPIKEY)
-	paramGeocode := json.RawMessage([]byte(`{
-		"type": "object",
-		"required": [
-			"location"
-		],
-		"properties": {
-			"location": {
-				"type": "string",
-				"description": "The city, e.g. New York"
-			}
-		}
-	}`))
-
-	paramWeather := json.RawMessage([]byte(`{
-		"type": "object",
-		"required": [
-			"latitude",
-			"longitude"
-		],
-		"properties": {
-			"latitude": {
-				"type": "number",
-				"description": "The latitude"
-			},
-			"longitude": {
-				"type": "number",
-				"description": "The longitude"
-			}
+	if len(resp.ToolCalls) > 0 {
+		newMessages := append([]llm.Message{}, messages...)
+		newMessages = append(newMessages, llm.Message{Role: "assistant", Content: resp.Content, ToolCalls: resp.ToolCalls})
+		newMessages = append(newMessages, dispatchToolCalls(ctx, resp.ToolCalls)...)
+
+		if err := store.Save(ctx, sessionID, newMessages); err != nil {
+			return "", fmt.Errorf("failed to persist conversation: %v", err)
 		}
-	}`))
-
-	return client.CreateChatCompletion(
-		context.Background(),
-		op.ChatCompletionRequest{
-			Model:    op.GPT3Dot5Turbo,
-			Messages: messages,
-			Functions: []op.FunctionDefinition{
-				{
-					Name:        "geocode",
-					Description: "Get the latitude and longitude of a location",
-					Parameters:  paramGeocode,
-				},
-				{
-					Name:        "weather",
-					Description: "Get the current weather in a given location",
-					Parameters:  paramWeather,
-				},
-			},
-		},
-	)
-}
 
-func invokeFunction(name, argsIn string) ([]byte, error) {
-	args := make(map[string]interface{})
-	err := json.Unmarshal([]byte(argsIn), &args)
+		return converse(ctx, sessionID, newMessages, turn+1)
+	}
 
-	fmt.Println("DEBUG invoke function ", name, args)
+	final := append([]llm.Message{}, messages...)
+	final = append(final, llm.Message{Role: "assistant", Content: resp.Content})
+	if err := store.Save(ctx, sessionID, final); err != nil {
+		return "", fmt.Errorf("failed to persist conversation: %v", err)
+	}
 
+	return resp.Content, nil
+}
+
+func query(ctx context.Context, sessionID, inquiry string) (string, error) {
+	messages, err := store.Load(ctx, sessionID)
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("failed to load conversation: %v", err)
 	}
-	switch name {
-	case "geocode":
-		return geocode(args["location"].(string))
-	case "weather":
-		return weather(args["latitude"].(string), args["longitude"].(string))
+	if len(messages) == 0 {
+		messages = []llm.Message{
+			{
+				Role:    "system",
+				Content: "Only use the functions you have been provided with.",
+			},
+			{
+				Role:    "system",
+				Content: "Only answer in 50 words or less.",
+			},
+		}
 	}
+	messages = append(messages, llm.Message{Role: "user", Content: inquiry})
 
-	return nil, nil
+	return converse(ctx, sessionID, messages, 0)
 }
 
-func converse(messages []op.ChatCompletionMessage) (string, error) {
-	if len(messages) > 13 {
-		return "", errors.New("too in-depth conversation")
+// converseStream drives one streamed chat turn, forwarding content tokens
+// to tokens as they arrive and persisting the conversation under
+// sessionID after every assistant turn. If the model finishes with
+// tool_calls, it dispatches them and opens a new stream for the
+// follow-up turn, recursing up to maxTurns deep so a model that never
+// stops requesting tools can't recurse forever.
+func converseStream(ctx context.Context, sessionID string, messages []llm.Message, tokens chan<- string, errs chan<- error, turn int) {
+	if turn >= maxTurns {
+		errs <- fmt.Errorf("exceeded max turns (%d) chasing tool calls", maxTurns)
+		return
 	}
 
-	resp, err := chat(messages)
+	messages, err := memory.Condense(ctx, budget, summary, messages)
 	if err != nil {
-		return "", fmt.Errorf("failed chat: %v", err)
+		errs <- fmt.Errorf("failed to condense conversation: %v", err)
+		return
 	}
 
-	for i, choice := range resp.Choices {
-		fmt.Printf("DEBUG choice %d: %+v\n", i, choice.Message)
+	chunks, chunkErrs := backend.ChatStream(ctx, llm.Request{Messages: messages, Tools: toolDefinitions()})
+
+	var content strings.Builder
+	var toolCalls []llm.ToolCall
+
+	for chunks != nil || chunkErrs != nil {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				chunks = nil
+				continue
+			}
+			if chunk.Content != "" {
+				content.WriteString(chunk.Content)
+				tokens <- chunk.Content
+			}
+			if chunk.Done {
+				toolCalls = chunk.ToolCalls
+			}
+		case err, ok := <-chunkErrs:
+			if !ok {
+				chunkErrs = nil
+				continue
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+		}
 	}
 
-	message := resp.Choices[0].Message
-	if functionCall := message.FunctionCall; functionCall != nil {
-		resp, err := invokeFunction(functionCall.Name, functionCall.Arguments)
-		if err != nil {
-			return "", fmt.Errorf("failed invoke function: %v", err)
+	if len(toolCalls) == 0 {
+		final := append([]llm.Message{}, messages...)
+		final = append(final, llm.Message{Role: "assistant", Content: content.String()})
+		if err := store.Save(ctx, sessionID, final); err != nil {
+			errs <- fmt.Errorf("failed to persist conversation: %v", err)
 		}
-		newMessages := append([]op.ChatCompletionMessage{}, messages...)
-		newMessages = append(newMessages, message)
-		newMessages = append(newMessages, op.ChatCompletionMessage{
-			Role:    op.ChatMessageRoleFunction,
-			Name:    functionCall.Name,
-			Content: string(resp),
-		})
+		return
+	}
 
-		return converse(newMessages)
+	newMessages := append([]llm.Message{}, messages...)
+	newMessages = append(newMessages, llm.Message{Role: "assistant", Content: content.String(), ToolCalls: toolCalls})
+	newMessages = append(newMessages, dispatchToolCalls(ctx, toolCalls)...)
+
+	if err := store.Save(ctx, sessionID, newMessages); err != nil {
+		errs <- fmt.Errorf("failed to persist conversation: %v", err)
+		return
 	}
 
-	return message.Content, nil
+	converseStream(ctx, sessionID, newMessages, tokens, errs, turn+1)
 }
 
-func query(inquiry string) (string, error) {
-	return converse([]op.ChatCompletionMessage{
-		{
-			Role:    "system",
-			Content: "Only use the functions you have been provided with.",
-		},
-		{
-			Role:    "system",
-			Content: "Only answer in 50 words or less.",
-		},
-		{
-			Role:    "user",
-			Content: inquiry,
-		},
-	})
+// queryStream is the streaming counterpart of query: it returns a channel
+// of content tokens as they arrive from the model and a channel that
+// carries at most one error. Both channels are closed once the turn
+// (including any tool round-trips) completes.
+func queryStream(ctx context.Context, sessionID, inquiry string) (<-chan string, <-chan error) {
+	tokens := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(tokens)
+		defer close(errs)
+
+		messages, err := store.Load(ctx, sessionID)
+		if err != nil {
+			errs <- fmt.Errorf("failed to load conversation: %v", err)
+			return
+		}
+		if len(messages) == 0 {
+			messages = []llm.Message{
+				{
+					Role:    "system",
+					Content: "Only use the functions you have been provided with.",
+				},
+				{
+					Role:    "system",
+					Content: "Only answer in 50 words or less.",
+				},
+			}
+		}
+		messages = append(messages, llm.Message{Role: "user", Content: inquiry})
+
+		converseStream(ctx, sessionID, messages, tokens, errs, 0)
+	}()
+
+	return tokens, errs
 }
 
 func init() {
 	godotenv.Load(".env")
-	openWeatherMapAPIKEY = os.Getenv("OPENWEATHERMAP_API_KEY")
-	openAIAPIKEY = os.Getenv("OPENAI_API_KEY")
+
+	if err := registry.Register(tools.NewGeocodeTool()); err != nil {
+		log.Fatalf("failed to register geocode tool: %v", err)
+	}
+	if err := registry.Register(tools.NewWeatherTool(os.Getenv("OPENWEATHERMAP_API_KEY"))); err != nil {
+		log.Fatalf("failed to register weather tool: %v", err)
+	}
+	if err := registry.Register(tools.NewForecastTool(os.Getenv("OPENWEATHERMAP_API_KEY"))); err != nil {
+		log.Fatalf("failed to register weather_forecast tool: %v", err)
+	}
+
+	var err error
+	backend, err = llm.NewFromEnv(context.Background())
+	if err != nil {
+		log.Fatalf("failed to configure LLM backend: %v", err)
+	}
+
+	if dbPath := os.Getenv("CONVERSATION_DB_PATH"); dbPath != "" {
+		store, err = memory.NewBoltStore(dbPath)
+		if err != nil {
+			log.Fatalf("failed to open conversation store: %v", err)
+		}
+	} else {
+		store = memory.NewInMemoryStore()
+	}
+
+	tokenBudget := defaultTokenBudget
+	if v := os.Getenv("CONVERSATION_TOKEN_BUDGET"); v != "" {
+		tokenBudget, err = strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid CONVERSATION_TOKEN_BUDGET %q: %v", v, err)
+		}
+	}
+	budget, err = memory.NewBudget(tokenBudget)
+	if err != nil {
+		log.Fatalf("failed to configure conversation budget: %v", err)
+	}
+
+	summaryModel := os.Getenv("SUMMARY_MODEL")
+	if summaryModel == "" {
+		summaryModel = "gpt-3.5-turbo"
+	}
+	summary = memory.NewSummarizer(llm.NewOpenAIBackend(os.Getenv("OPENAI_API_KEY"), "", summaryModel), summaryModel)
 }
 
 func main() {
-	inquiry := ""
-	if len(os.Args) > 1 {
-		inquiry = strings.Join(os.Args[1:], " ")
-	}
+	stream := flag.Bool("stream", false, "print tokens as they arrive instead of waiting for the full answer")
+	session := flag.String("session", "default", "conversation session id to persist history under")
+	flag.Parse()
+
+	inquiry := strings.Join(flag.Args(), " ")
 	if len(inquiry) < 2 {
 		log.Fatal("Supply some inquiry!")
 	}
-	answer, err := query(inquiry)
+
+	if *stream {
+		tokens, errs := queryStream(context.Background(), *session, inquiry)
+		for tokens != nil || errs != nil {
+			select {
+			case tok, ok := <-tokens:
+				if !ok {
+					tokens = nil
+					continue
+				}
+				fmt.Print(tok)
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if err != nil {
+					log.Fatalf("Failed query answer: %v", err)
+				}
+			}
+		}
+		fmt.Println()
+		return
+	}
+
+	answer, err := query(context.Background(), *session, inquiry)
 	if err != nil {
 		log.Fatalf("Failed query answer: %v", err)
 	}
@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewFromEnv builds the Backend selected by the LLM_PROVIDER environment
+// variable ("openai", "gemini", "moonshot", or "local"; defaults to
+// "openai"), with LLM_BASE_URL and LLM_MODEL as optional overrides.
+// LLM_BASE_URL is not used by the "gemini" provider.
+func NewFromEnv(ctx context.Context) (Backend, error) {
+	provider := strings.ToLower(os.Getenv("LLM_PROVIDER"))
+	baseURL := os.Getenv("LLM_BASE_URL")
+	model := os.Getenv("LLM_MODEL")
+
+	switch provider {
+	case "", "openai":
+		return NewOpenAIBackend(os.Getenv("OPENAI_API_KEY"), baseURL, model), nil
+
+	case "moonshot":
+		if baseURL == "" {
+			baseURL = "https://api.moonshot.cn/v1"
+		}
+		if model == "" {
+			model = "moonshot-v1-8k"
+		}
+		return NewOpenAIBackend(os.Getenv("MOONSHOT_API_KEY"), baseURL, model), nil
+
+	case "local":
+		if baseURL == "" {
+			baseURL = "http://localhost:8080/v1"
+		}
+		return NewOpenAIBackend(os.Getenv("LOCAL_LLM_API_KEY"), baseURL, model), nil
+
+	case "gemini":
+		return NewGeminiBackend(ctx, os.Getenv("GEMINI_API_KEY"), model)
+
+	default:
+		return nil, fmt.Errorf("llm: unknown LLM_PROVIDER %q", provider)
+	}
+}
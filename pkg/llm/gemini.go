@@ -0,0 +1,252 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// GeminiBackend talks to Google's Gemini API. Unlike the OpenAI-compatible
+// backends, Gemini has its own tool schema (FunctionDeclaration/Schema),
+// so ToolDefinition's JSON-Schema parameters are translated rather than
+// forwarded verbatim.
+type GeminiBackend struct {
+	client *genai.Client
+	model  string
+}
+
+// NewGeminiBackend dials Gemini with apiKey. model defaults to
+// "gemini-1.5-flash" when empty.
+func NewGeminiBackend(ctx context.Context, apiKey, model string) (*GeminiBackend, error) {
+	client, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("gemini: failed to create client: %v", err)
+	}
+	if model == "" {
+		model = "gemini-1.5-flash"
+	}
+	return &GeminiBackend{client: client, model: model}, nil
+}
+
+// jsonSchema is the subset of JSON-Schema we translate into genai.Schema.
+type jsonSchema struct {
+	Type        string                `json:"type"`
+	Description string                `json:"description"`
+	Enum        []string              `json:"enum"`
+	Required    []string              `json:"required"`
+	Properties  map[string]jsonSchema `json:"properties"`
+	Items       *jsonSchema           `json:"items"`
+}
+
+func (s jsonSchema) toGenai() *genai.Schema {
+	out := &genai.Schema{
+		Type:        genaiType(s.Type),
+		Description: s.Description,
+		Enum:        s.Enum,
+		Required:    s.Required,
+	}
+	if len(s.Properties) > 0 {
+		out.Properties = make(map[string]*genai.Schema, len(s.Properties))
+		for name, prop := range s.Properties {
+			out.Properties[name] = prop.toGenai()
+		}
+	}
+	if s.Items != nil {
+		out.Items = s.Items.toGenai()
+	}
+	return out
+}
+
+func genaiType(t string) genai.Type {
+	switch t {
+	case "string":
+		return genai.TypeString
+	case "number":
+		return genai.TypeNumber
+	case "integer":
+		return genai.TypeInteger
+	case "boolean":
+		return genai.TypeBoolean
+	case "array":
+		return genai.TypeArray
+	case "object":
+		return genai.TypeObject
+	default:
+		return genai.TypeUnspecified
+	}
+}
+
+func translateToolDefinition(t ToolDefinition) (*genai.FunctionDeclaration, error) {
+	var schema jsonSchema
+	if err := json.Unmarshal(t.Parameters, &schema); err != nil {
+		return nil, fmt.Errorf("gemini: failed to translate schema for %q: %v", t.Name, err)
+	}
+	return &genai.FunctionDeclaration{
+		Name:        t.Name,
+		Description: t.Description,
+		Parameters:  schema.toGenai(),
+	}, nil
+}
+
+func (b *GeminiBackend) newModel(req Request) (*genai.GenerativeModel, error) {
+	model := b.client.GenerativeModel(b.model)
+	if req.Model != "" {
+		model = b.client.GenerativeModel(req.Model)
+	}
+
+	var systemPrompts []string
+	for _, m := range req.Messages {
+		if m.Role == "system" {
+			systemPrompts = append(systemPrompts, m.Content)
+		}
+	}
+	if len(systemPrompts) > 0 {
+		model.SystemInstruction = &genai.Content{Parts: []genai.Part{genai.Text(strings.Join(systemPrompts, "\n"))}}
+	}
+
+	if len(req.Tools) > 0 {
+		decls := make([]*genai.FunctionDeclaration, 0, len(req.Tools))
+		for _, t := range req.Tools {
+			decl, err := translateToolDefinition(t)
+			if err != nil {
+				return nil, err
+			}
+			decls = append(decls, decl)
+		}
+		model.Tools = []*genai.Tool{{FunctionDeclarations: decls}}
+	}
+
+	return model, nil
+}
+
+// history turns every non-system message into Gemini chat history, except
+// a user message that is the final element of messages: that one is
+// returned as the part to send as the new turn. If messages doesn't end
+// with a user message, every non-system message goes into history and the returned
+// part is empty text.
+func history(messages []Message) ([]*genai.Content, genai.Part) {
+	var hist []*genai.Content
+	var last genai.Part = genai.Text("")
+
+	for i, m := range messages {
+		switch m.Role {
+		case "system":
+			continue
+		case "user":
+			if i == len(messages)-1 {
+				last = genai.Text(m.Content)
+				continue
+			}
+			hist = append(hist, &genai.Content{Role: "user", Parts: []genai.Part{genai.Text(m.Content)}})
+		case "assistant":
+			var parts []genai.Part
+			if m.Content != "" {
+				parts = append(parts, genai.Text(m.Content))
+			}
+			for _, tc := range m.ToolCalls {
+				var args map[string]interface{}
+				_ = json.Unmarshal([]byte(tc.Arguments), &args)
+				parts = append(parts, genai.FunctionCall{Name: tc.Name, Args: args})
+			}
+			hist = append(hist, &genai.Content{Role: "model", Parts: parts})
+		case "tool":
+			var payload map[string]interface{}
+			_ = json.Unmarshal([]byte(m.Content), &payload)
+			hist = append(hist, &genai.Content{Role: "function", Parts: []genai.Part{genai.FunctionResponse{
+				Name:     m.Name,
+				Response: payload,
+			}}})
+		}
+	}
+
+	return hist, last
+}
+
+func responseFromCandidate(resp *genai.GenerateContentResponse) Response {
+	var out Response
+	if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+		return out
+	}
+
+	for _, part := range resp.Candidates[0].Content.Parts {
+		switch p := part.(type) {
+		case genai.Text:
+			out.Content += string(p)
+		case genai.FunctionCall:
+			args, _ := json.Marshal(p.Args)
+			out.ToolCalls = append(out.ToolCalls, ToolCall{Name: p.Name, Arguments: string(args)})
+		}
+	}
+	return out
+}
+
+func (b *GeminiBackend) Chat(ctx context.Context, req Request) (Response, error) {
+	model, err := b.newModel(req)
+	if err != nil {
+		return Response{}, err
+	}
+
+	hist, last := history(req.Messages)
+	session := model.StartChat()
+	session.History = hist
+
+	resp, err := session.SendMessage(ctx, last)
+	if err != nil {
+		return Response{}, err
+	}
+	return responseFromCandidate(resp), nil
+}
+
+// ChatStream streams text tokens as they arrive. Gemini's streaming API
+// surfaces function calls only on the final chunk of a turn, so unlike
+// OpenAI there's nothing to assemble incrementally: the one ToolCalls
+// chunk is emitted as soon as it's seen.
+func (b *GeminiBackend) ChatStream(ctx context.Context, req Request) (<-chan StreamChunk, <-chan error) {
+	chunks := make(chan StreamChunk)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		defer close(errs)
+
+		model, err := b.newModel(req)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		hist, last := history(req.Messages)
+		session := model.StartChat()
+		session.History = hist
+
+		iter := session.SendMessageStream(ctx, last)
+		for {
+			resp, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				errs <- fmt.Errorf("gemini: failed stream recv: %v", err)
+				return
+			}
+
+			part := responseFromCandidate(resp)
+			if part.Content != "" {
+				chunks <- StreamChunk{Content: part.Content}
+			}
+			if len(part.ToolCalls) > 0 {
+				chunks <- StreamChunk{Done: true, ToolCalls: part.ToolCalls}
+				return
+			}
+		}
+		chunks <- StreamChunk{Done: true}
+	}()
+
+	return chunks, errs
+}
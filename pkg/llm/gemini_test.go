@@ -0,0 +1,162 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+func TestTranslateToolDefinition(t *testing.T) {
+	params := json.RawMessage(`{
+		"type": "object",
+		"properties": {
+			"city": {"type": "string"},
+			"unit": {"type": "string", "enum": ["c", "f"]},
+			"days": {"type": "array", "items": {"type": "integer"}}
+		},
+		"required": ["city"]
+	}`)
+
+	decl, err := translateToolDefinition(ToolDefinition{Name: "forecast", Description: "get forecast", Parameters: params})
+	if err != nil {
+		t.Fatalf("translateToolDefinition: %v", err)
+	}
+
+	if decl.Name != "forecast" || decl.Description != "get forecast" {
+		t.Errorf("decl = %+v, name/description not preserved", decl)
+	}
+
+	schema := decl.Parameters
+	if schema.Type != genai.TypeObject {
+		t.Errorf("Type = %v, want %v", schema.Type, genai.TypeObject)
+	}
+	if got, want := schema.Required, []string{"city"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Required = %v, want %v", got, want)
+	}
+
+	unit, ok := schema.Properties["unit"]
+	if !ok {
+		t.Fatalf("Properties[unit] missing")
+	}
+	if unit.Type != genai.TypeString || len(unit.Enum) != 2 || unit.Enum[0] != "c" || unit.Enum[1] != "f" {
+		t.Errorf("Properties[unit] = %+v, enum not preserved", unit)
+	}
+
+	days, ok := schema.Properties["days"]
+	if !ok {
+		t.Fatalf("Properties[days] missing")
+	}
+	if days.Type != genai.TypeArray || days.Items == nil || days.Items.Type != genai.TypeInteger {
+		t.Errorf("Properties[days] = %+v, array items not translated", days)
+	}
+}
+
+func TestNewModel_JoinsMultipleSystemMessages(t *testing.T) {
+	b, err := NewGeminiBackend(context.Background(), "fake-key", "")
+	if err != nil {
+		t.Fatalf("NewGeminiBackend: %v", err)
+	}
+
+	model, err := b.newModel(Request{Messages: []Message{
+		{Role: "system", Content: "Only use the functions you have been provided with."},
+		{Role: "system", Content: "Only answer in 50 words or less."},
+		{Role: "user", Content: "hi"},
+	}})
+	if err != nil {
+		t.Fatalf("newModel: %v", err)
+	}
+
+	if model.SystemInstruction == nil || len(model.SystemInstruction.Parts) != 1 {
+		t.Fatalf("SystemInstruction = %+v, want one joined part", model.SystemInstruction)
+	}
+	got := model.SystemInstruction.Parts[0].(genai.Text)
+	want := genai.Text("Only use the functions you have been provided with.\nOnly answer in 50 words or less.")
+	if got != want {
+		t.Errorf("SystemInstruction = %q, want %q (first instruction must not be discarded)", got, want)
+	}
+}
+
+func TestGenaiType_UnknownFallsBackToUnspecified(t *testing.T) {
+	if got := genaiType("nonsense"); got != genai.TypeUnspecified {
+		t.Errorf("genaiType(nonsense) = %v, want %v", got, genai.TypeUnspecified)
+	}
+}
+
+func TestHistory_AssistantToolCallsBecomeFunctionCallParts(t *testing.T) {
+	messages := []Message{
+		{Role: "system", Content: "be terse"},
+		{Role: "user", Content: "what's the weather in Paris?"},
+		{
+			Role:      "assistant",
+			Content:   "",
+			ToolCalls: []ToolCall{{ID: "call_1", Name: "weather", Arguments: `{"city":"Paris"}`}},
+		},
+		{Role: "tool", Name: "weather", Content: `{"tempC":21}`},
+		{Role: "user", Content: "and tomorrow?"},
+	}
+
+	hist, last := history(messages)
+
+	if got, want := last, genai.Text("and tomorrow?"); got != want {
+		t.Errorf("last = %v, want %v", got, want)
+	}
+
+	// system is dropped, the final user turn is excluded from history, so
+	// history holds: user, assistant (model), tool (function).
+	if len(hist) != 3 {
+		t.Fatalf("len(hist) = %d, want 3: %+v", len(hist), hist)
+	}
+
+	assistantTurn := hist[1]
+	if assistantTurn.Role != "model" {
+		t.Fatalf("hist[1].Role = %q, want %q", assistantTurn.Role, "model")
+	}
+	if len(assistantTurn.Parts) != 1 {
+		t.Fatalf("hist[1].Parts = %+v, want exactly the FunctionCall part", assistantTurn.Parts)
+	}
+	call, ok := assistantTurn.Parts[0].(genai.FunctionCall)
+	if !ok {
+		t.Fatalf("hist[1].Parts[0] = %T, want genai.FunctionCall", assistantTurn.Parts[0])
+	}
+	if call.Name != "weather" || call.Args["city"] != "Paris" {
+		t.Errorf("FunctionCall = %+v, not translated from ToolCalls", call)
+	}
+}
+
+func TestResponseFromCandidate(t *testing.T) {
+	resp := &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{{
+			Content: &genai.Content{Parts: []genai.Part{
+				genai.Text("the weather is "),
+				genai.Text("sunny"),
+				genai.FunctionCall{Name: "weather", Args: map[string]interface{}{"city": "Paris"}},
+			}},
+		}},
+	}
+
+	out := responseFromCandidate(resp)
+
+	if out.Content != "the weather is sunny" {
+		t.Errorf("Content = %q, want %q", out.Content, "the weather is sunny")
+	}
+	if len(out.ToolCalls) != 1 || out.ToolCalls[0].Name != "weather" {
+		t.Fatalf("ToolCalls = %+v, not translated", out.ToolCalls)
+	}
+
+	var args map[string]interface{}
+	if err := json.Unmarshal([]byte(out.ToolCalls[0].Arguments), &args); err != nil {
+		t.Fatalf("Arguments not valid JSON: %v", err)
+	}
+	if args["city"] != "Paris" {
+		t.Errorf("Arguments = %v, city not preserved", args)
+	}
+}
+
+func TestResponseFromCandidate_NoCandidates(t *testing.T) {
+	out := responseFromCandidate(&genai.GenerateContentResponse{})
+	if out.Content != "" || out.ToolCalls != nil {
+		t.Errorf("responseFromCandidate(empty) = %+v, want zero value", out)
+	}
+}
@@ -0,0 +1,72 @@
+// Package llm abstracts chat completion over multiple model providers
+// (OpenAI, Gemini, Moonshot, a local OpenAI-compatible endpoint) behind
+// one Backend interface, so the rest of the assistant doesn't need to
+// know which provider answered the request.
+package llm
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Message is a provider-agnostic chat message.
+type Message struct {
+	Role       string
+	Content    string
+	Name       string
+	ToolCallID string
+	ToolCalls  []ToolCall
+}
+
+// ToolCall is a complete, provider-agnostic function call requested by
+// the model.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// ToolDefinition describes a callable tool using JSON-Schema parameters,
+// the lingua franca every provider's translation layer maps from.
+type ToolDefinition struct {
+	Name        string
+	Description string
+	Parameters  json.RawMessage
+}
+
+// Request is a provider-agnostic chat completion request.
+type Request struct {
+	Model    string
+	Messages []Message
+	Tools    []ToolDefinition
+}
+
+// Response is a complete, non-streamed chat completion result.
+type Response struct {
+	Content   string
+	ToolCalls []ToolCall
+}
+
+// ToolCallDelta is one incremental update to a tool call's arguments,
+// addressed by Index the same way OpenAI's streaming API does.
+type ToolCallDelta struct {
+	Index          int
+	ID             string
+	Name           string
+	ArgumentsDelta string
+}
+
+// StreamChunk is one event from a streamed chat completion.
+type StreamChunk struct {
+	Content       string
+	ToolCallDelta *ToolCallDelta
+	// Done is set on the final chunk; ToolCalls is only populated then.
+	Done      bool
+	ToolCalls []ToolCall
+}
+
+// Backend is a chat completion provider.
+type Backend interface {
+	Chat(ctx context.Context, req Request) (Response, error)
+	ChatStream(ctx context.Context, req Request) (<-chan StreamChunk, <-chan error)
+}
@@ -0,0 +1,205 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	op "github.com/sashabaranov/go-openai"
+)
+
+// OpenAIBackend talks to any OpenAI-compatible chat completion endpoint:
+// OpenAI itself, Moonshot (which mirrors the OpenAI schema, including its
+// array `required` fields and `enum` constraints), or a local LocalAI /
+// llama.cpp server.
+type OpenAIBackend struct {
+	client *op.Client
+	model  string
+}
+
+// NewOpenAIBackend builds a backend authenticating with apiKey. If
+// baseURL is non-empty, it's used in place of api.openai.com, which is
+// how Moonshot and local servers are targeted. model is the default used
+// when a Request doesn't set one.
+func NewOpenAIBackend(apiKey, baseURL, model string) *OpenAIBackend {
+	cfg := op.DefaultConfig(apiKey)
+	if baseURL != "" {
+		cfg.BaseURL = baseURL
+	}
+	if model == "" {
+		model = op.GPT3Dot5Turbo
+	}
+	return &OpenAIBackend{client: op.NewClientWithConfig(cfg), model: model}
+}
+
+func (b *OpenAIBackend) request(req Request) op.ChatCompletionRequest {
+	model := req.Model
+	if model == "" {
+		model = b.model
+	}
+
+	var tools []op.Tool
+	for _, t := range req.Tools {
+		tools = append(tools, op.Tool{
+			Type: op.ToolTypeFunction,
+			Function: &op.FunctionDefinition{
+				Name:        t.Name,
+				Description: t.Description,
+				// Parameters is forwarded byte-for-byte: Moonshot's array
+				// `required` fields and `enum` values must survive as-is.
+				Parameters: t.Parameters,
+			},
+		})
+	}
+
+	messages := make([]op.ChatCompletionMessage, 0, len(req.Messages))
+	for _, m := range req.Messages {
+		msg := op.ChatCompletionMessage{
+			Role:       m.Role,
+			Content:    m.Content,
+			Name:       m.Name,
+			ToolCallID: m.ToolCallID,
+		}
+		for _, tc := range m.ToolCalls {
+			msg.ToolCalls = append(msg.ToolCalls, op.ToolCall{
+				ID:   tc.ID,
+				Type: op.ToolTypeFunction,
+				Function: op.FunctionCall{
+					Name:      tc.Name,
+					Arguments: tc.Arguments,
+				},
+			})
+		}
+		messages = append(messages, msg)
+	}
+
+	out := op.ChatCompletionRequest{
+		Model:    model,
+		Messages: messages,
+		Tools:    tools,
+	}
+	if len(tools) > 0 {
+		out.ToolChoice = "auto"
+	}
+	return out
+}
+
+func (b *OpenAIBackend) Chat(ctx context.Context, req Request) (Response, error) {
+	resp, err := b.client.CreateChatCompletion(ctx, b.request(req))
+	if err != nil {
+		return Response{}, err
+	}
+	if len(resp.Choices) == 0 {
+		return Response{}, errors.New("openai: no choices returned")
+	}
+
+	message := resp.Choices[0].Message
+	out := Response{Content: message.Content}
+	for _, tc := range message.ToolCalls {
+		out.ToolCalls = append(out.ToolCalls, ToolCall{
+			ID:        tc.ID,
+			Name:      tc.Function.Name,
+			Arguments: tc.Function.Arguments,
+		})
+	}
+	return out, nil
+}
+
+func (b *OpenAIBackend) ChatStream(ctx context.Context, req Request) (<-chan StreamChunk, <-chan error) {
+	chunks := make(chan StreamChunk)
+	errs := make(chan error, 1)
+
+	apiReq := b.request(req)
+	apiReq.Stream = true
+
+	go func() {
+		defer close(chunks)
+		defer close(errs)
+
+		stream, err := b.client.CreateChatCompletionStream(ctx, apiReq)
+		if err != nil {
+			errs <- fmt.Errorf("failed chat stream: %v", err)
+			return
+		}
+		defer stream.Close()
+
+		type pendingCall struct {
+			id   string
+			name string
+			args strings.Builder
+		}
+		pending := map[int]*pendingCall{}
+
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				errs <- fmt.Errorf("failed stream recv: %v", err)
+				return
+			}
+
+			if len(resp.Choices) == 0 {
+				// Some OpenAI-compatible providers emit chunks with no
+				// choices, e.g. a content-filter-results chunk or the
+				// final usage-only chunk when stream_options.include_usage
+				// is set. Nothing to do with those.
+				continue
+			}
+
+			choice := resp.Choices[0]
+			if choice.Delta.Content != "" {
+				chunks <- StreamChunk{Content: choice.Delta.Content}
+			}
+
+			for _, tc := range choice.Delta.ToolCalls {
+				idx := 0
+				if tc.Index != nil {
+					idx = *tc.Index
+				}
+				call, ok := pending[idx]
+				if !ok {
+					call = &pendingCall{}
+					pending[idx] = call
+				}
+				if tc.ID != "" {
+					call.id = tc.ID
+				}
+				if tc.Function.Name != "" {
+					call.name = tc.Function.Name
+				}
+				call.args.WriteString(tc.Function.Arguments)
+
+				chunks <- StreamChunk{ToolCallDelta: &ToolCallDelta{
+					Index:          idx,
+					ID:             tc.ID,
+					Name:           tc.Function.Name,
+					ArgumentsDelta: tc.Function.Arguments,
+				}}
+			}
+
+			if choice.FinishReason == op.FinishReasonToolCalls {
+				indexes := make([]int, 0, len(pending))
+				for idx := range pending {
+					indexes = append(indexes, idx)
+				}
+				sort.Ints(indexes)
+
+				var toolCalls []ToolCall
+				for _, idx := range indexes {
+					call := pending[idx]
+					toolCalls = append(toolCalls, ToolCall{ID: call.id, Name: call.name, Arguments: call.args.String()})
+				}
+				chunks <- StreamChunk{Done: true, ToolCalls: toolCalls}
+			} else if choice.FinishReason != "" {
+				chunks <- StreamChunk{Done: true}
+			}
+		}
+	}()
+
+	return chunks, errs
+}
@@ -0,0 +1,60 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// sseChatChunk writes one `data: ...` SSE line; choices == "" emits a
+// chunk with no choices at all, like a content-filter-results chunk or
+// the final usage-only chunk some OpenAI-compatible providers send.
+func sseChatChunk(w http.ResponseWriter, choices string) {
+	fmt.Fprintf(w, "data: {\"id\":\"1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"m\",\"choices\":[%s]}\n\n", choices)
+}
+
+func TestOpenAIBackendChatStream_SkipsChunksWithNoChoices(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+
+		sseChatChunk(w, "") // e.g. a usage-only or content-filter chunk
+		flusher.Flush()
+		sseChatChunk(w, `{"index":0,"delta":{"content":"hi"},"finish_reason":""}`)
+		flusher.Flush()
+		sseChatChunk(w, `{"index":0,"delta":{},"finish_reason":"stop"}`)
+		flusher.Flush()
+		fmt.Fprint(w, "data: [DONE]\n\n")
+		flusher.Flush()
+	}))
+	defer srv.Close()
+
+	b := NewOpenAIBackend("key", srv.URL, "")
+	chunks, errs := b.ChatStream(context.Background(), Request{Messages: []Message{{Role: "user", Content: "hello"}}})
+
+	var content string
+	for chunks != nil || errs != nil {
+		select {
+		case c, ok := <-chunks:
+			if !ok {
+				chunks = nil
+				continue
+			}
+			content += c.Content
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if err != nil {
+				t.Fatalf("ChatStream error: %v", err)
+			}
+		}
+	}
+
+	if content != "hi" {
+		t.Errorf("content = %q, want %q", content, "hi")
+	}
+}
@@ -0,0 +1,84 @@
+package llm
+
+import (
+	"encoding/json"
+	"testing"
+
+	op "github.com/sashabaranov/go-openai"
+)
+
+func TestOpenAIBackendRequest_ParametersForwardedVerbatim(t *testing.T) {
+	// Moonshot mirrors the OpenAI schema but relies on array `required`
+	// fields and `enum` constraints that a re-marshal could reorder or
+	// drop; Parameters must survive byte-for-byte.
+	params := json.RawMessage(`{"type":"object","properties":{"unit":{"type":"string","enum":["c","f"]}},"required":["unit"]}`)
+
+	b := NewOpenAIBackend("key", "", "")
+	req := b.request(Request{Tools: []ToolDefinition{{Name: "weather", Description: "get weather", Parameters: params}}})
+
+	if len(req.Tools) != 1 {
+		t.Fatalf("expected 1 tool, got %d", len(req.Tools))
+	}
+	if got := string(req.Tools[0].Function.Parameters.(json.RawMessage)); got != string(params) {
+		t.Errorf("Parameters = %s, want %s", got, params)
+	}
+}
+
+func TestOpenAIBackendRequest_DefaultModel(t *testing.T) {
+	b := NewOpenAIBackend("key", "", "moonshot-v1-8k")
+
+	req := b.request(Request{})
+	if req.Model != "moonshot-v1-8k" {
+		t.Errorf("Model = %q, want %q", req.Model, "moonshot-v1-8k")
+	}
+
+	req = b.request(Request{Model: "gpt-4o"})
+	if req.Model != "gpt-4o" {
+		t.Errorf("Model = %q, want %q", req.Model, "gpt-4o")
+	}
+}
+
+func TestOpenAIBackendRequest_ToolCallsTranslated(t *testing.T) {
+	b := NewOpenAIBackend("key", "", "")
+
+	req := b.request(Request{Messages: []Message{
+		{
+			Role:    "assistant",
+			Content: "",
+			ToolCalls: []ToolCall{
+				{ID: "call_1", Name: "geocode", Arguments: `{"city":"Paris"}`},
+			},
+		},
+		{Role: "tool", Name: "geocode", ToolCallID: "call_1", Content: `{"lat":48.85}`},
+	}})
+
+	if len(req.Messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(req.Messages))
+	}
+
+	assistant := req.Messages[0]
+	if len(assistant.ToolCalls) != 1 {
+		t.Fatalf("expected 1 tool call, got %d", len(assistant.ToolCalls))
+	}
+	if tc := assistant.ToolCalls[0]; tc.ID != "call_1" || tc.Type != op.ToolTypeFunction || tc.Function.Name != "geocode" || tc.Function.Arguments != `{"city":"Paris"}` {
+		t.Errorf("ToolCalls[0] = %+v, not translated correctly", tc)
+	}
+
+	tool := req.Messages[1]
+	if tool.Role != "tool" || tool.ToolCallID != "call_1" || tool.Content != `{"lat":48.85}` {
+		t.Errorf("tool message = %+v, not translated correctly", tool)
+	}
+}
+
+func TestOpenAIBackendRequest_ToolChoiceOnlySetWithTools(t *testing.T) {
+	b := NewOpenAIBackend("key", "", "")
+
+	if req := b.request(Request{}); req.ToolChoice != nil {
+		t.Errorf("ToolChoice = %v, want nil when there are no tools", req.ToolChoice)
+	}
+
+	req := b.request(Request{Tools: []ToolDefinition{{Name: "geocode", Parameters: json.RawMessage(`{}`)}}})
+	if req.ToolChoice != "auto" {
+		t.Errorf("ToolChoice = %v, want %q", req.ToolChoice, "auto")
+	}
+}
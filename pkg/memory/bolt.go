@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+)
+
+var conversationsBucket = []byte("conversations")
+
+// BoltStore persists conversations to a BoltDB file, so a session survives
+// a process restart. Save writes the whole history in a single bbolt
+// transaction, so a crash mid-tool-roundtrip leaves the last fully saved
+// turn intact rather than a partially written one.
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("memory: failed to open bolt db: %v", err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(conversationsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("memory: failed to create bucket: %v", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close closes the underlying BoltDB file.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) Load(ctx context.Context, sessionID string) ([]llm.Message, error) {
+	var messages []llm.Message
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(conversationsBucket).Get([]byte(sessionID))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &messages)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("memory: failed to load session %q: %v", sessionID, err)
+	}
+
+	return messages, nil
+}
+
+func (s *BoltStore) Save(ctx context.Context, sessionID string, messages []llm.Message) error {
+	data, err := json.Marshal(messages)
+	if err != nil {
+		return fmt.Errorf("memory: failed to marshal session %q: %v", sessionID, err)
+	}
+
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(conversationsBucket).Put([]byte(sessionID), data)
+	})
+	if err != nil {
+		return fmt.Errorf("memory: failed to save session %q: %v", sessionID, err)
+	}
+
+	return nil
+}
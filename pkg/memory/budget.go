@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"fmt"
+
+	tiktoken "github.com/pkoukk/tiktoken-go"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+)
+
+// Budget estimates a conversation's token count and reports whether it has
+// outgrown a configured limit.
+type Budget struct {
+	maxTokens int
+	encoding  *tiktoken.Tiktoken
+}
+
+// NewBudget returns a Budget that flags conversations once they exceed
+// maxTokens, estimated with the cl100k_base encoding (used by GPT-3.5/4).
+func NewBudget(maxTokens int) (*Budget, error) {
+	enc, err := tiktoken.GetEncoding("cl100k_base")
+	if err != nil {
+		return nil, fmt.Errorf("memory: failed to load tiktoken encoding: %v", err)
+	}
+	return &Budget{maxTokens: maxTokens, encoding: enc}, nil
+}
+
+// CountTokens estimates the total token count across every message's
+// content.
+func (b *Budget) CountTokens(messages []llm.Message) int {
+	total := 0
+	for _, m := range messages {
+		total += len(b.encoding.Encode(m.Content, nil, nil))
+	}
+	return total
+}
+
+// Exceeded reports whether messages' estimated token count is over the
+// configured budget.
+func (b *Budget) Exceeded(messages []llm.Message) bool {
+	return b.CountTokens(messages) > b.maxTokens
+}
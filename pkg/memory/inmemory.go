@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+)
+
+// InMemoryStore keeps conversations in a process-local map. It satisfies
+// ConversationStore for tests and single-process use; it does not survive
+// a restart, unlike BoltStore.
+type InMemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string][]llm.Message
+}
+
+// NewInMemoryStore returns an empty InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{sessions: make(map[string][]llm.Message)}
+}
+
+func (s *InMemoryStore) Load(ctx context.Context, sessionID string) ([]llm.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]llm.Message{}, s.sessions[sessionID]...), nil
+}
+
+func (s *InMemoryStore) Save(ctx context.Context, sessionID string, messages []llm.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[sessionID] = append([]llm.Message{}, messages...)
+	return nil
+}
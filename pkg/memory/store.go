@@ -0,0 +1,19 @@
+// Package memory persists conversations across CLI invocations and RPCs,
+// and keeps them within a token budget by summarizing the oldest turns
+// once they no longer fit.
+package memory
+
+import (
+	"context"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+)
+
+// ConversationStore loads and saves the full message history for a
+// session ID. Save is expected to replace the whole history atomically,
+// so a crash mid-tool-roundtrip can be resumed from the last saved state
+// rather than a half-written one.
+type ConversationStore interface {
+	Load(ctx context.Context, sessionID string) ([]llm.Message, error)
+	Save(ctx context.Context, sessionID string, messages []llm.Message) error
+}
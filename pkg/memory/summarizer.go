@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+)
+
+// keepRecent is how many of the most recent non-system messages are
+// always left untouched, so the model keeps the immediate context of the
+// turn it's replying to.
+const keepRecent = 4
+
+// summaryPrefix marks a message Summarize produced, so a later Condense
+// can fold it back into the next summary instead of letting it sit
+// alongside a second, separate one.
+const summaryPrefix = "Summary of earlier conversation: "
+
+// Summarizer condenses the oldest messages of a conversation into a
+// single system message via a cheap model, once Budget says the
+// conversation no longer fits.
+type Summarizer struct {
+	backend llm.Backend
+	model   string
+}
+
+// NewSummarizer returns a Summarizer that asks backend for summaries
+// using model (e.g. a cheaper model than the one driving the
+// conversation).
+func NewSummarizer(backend llm.Backend, model string) *Summarizer {
+	return &Summarizer{backend: backend, model: model}
+}
+
+// Summarize condenses messages into one system message.
+func (s *Summarizer) Summarize(ctx context.Context, messages []llm.Message) (llm.Message, error) {
+	var transcript strings.Builder
+	for _, m := range messages {
+		fmt.Fprintf(&transcript, "%s: %s\n", m.Role, m.Content)
+	}
+
+	resp, err := s.backend.Chat(ctx, llm.Request{
+		Model: s.model,
+		Messages: []llm.Message{
+			{
+				Role:    "system",
+				Content: "Summarize the following conversation excerpt concisely, preserving any facts that might be needed later.",
+			},
+			{
+				Role:    "user",
+				Content: transcript.String(),
+			},
+		},
+	})
+	if err != nil {
+		return llm.Message{}, fmt.Errorf("memory: failed to summarize conversation: %v", err)
+	}
+
+	return llm.Message{Role: "system", Content: summaryPrefix + resp.Content}, nil
+}
+
+// Condense keeps messages within budget by replacing its oldest entries
+// with a summary, leaving any leading system messages and the keepRecent
+// most recent messages untouched. It's a no-op if messages already fit,
+// or if there isn't enough history to summarize. A summary from an
+// earlier Condense call is folded into the new one rather than kept
+// verbatim, so the summary block doesn't grow across a long session.
+func Condense(ctx context.Context, budget *Budget, summarizer *Summarizer, messages []llm.Message) ([]llm.Message, error) {
+	if !budget.Exceeded(messages) {
+		return messages, nil
+	}
+
+	var systemPrefix, rest []llm.Message
+	for _, m := range messages {
+		if m.Role == "system" && len(rest) == 0 {
+			systemPrefix = append(systemPrefix, m)
+			continue
+		}
+		rest = append(rest, m)
+	}
+
+	if n := len(systemPrefix); n > 0 && strings.HasPrefix(systemPrefix[n-1].Content, summaryPrefix) {
+		rest = append([]llm.Message{systemPrefix[n-1]}, rest...)
+		systemPrefix = systemPrefix[:n-1]
+	}
+
+	if len(rest) <= keepRecent {
+		return messages, nil
+	}
+
+	cut := len(rest) - keepRecent
+	oldest, recent := rest[:cut], rest[cut:]
+
+	summary, err := summarizer.Summarize(ctx, oldest)
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]llm.Message{}, systemPrefix...)
+	out = append(out, summary)
+	out = append(out, recent...)
+	return out, nil
+}
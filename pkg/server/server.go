@@ -0,0 +1,208 @@
+// Package server implements the Assistant gRPC service declared in
+// proto/assistant.proto: it drives the same chat+tools loop as the CLI,
+// but streams tokens and tool activity to the client as events.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sog01/go-openai-example/pkg/llm"
+	"github.com/sog01/go-openai-example/pkg/memory"
+	"github.com/sog01/go-openai-example/pkg/tools"
+	assistantpb "github.com/sog01/go-openai-example/proto"
+)
+
+// defaultSessionID is used when a client doesn't set AskRequest.SessionId.
+const defaultSessionID = "default"
+
+// maxTurns bounds how many times converse can recurse chasing tool_calls
+// in a single Ask, so a model that never stops requesting tools can't
+// recurse forever.
+const maxTurns = 13
+
+// Server implements assistantpb.AssistantServer.
+type Server struct {
+	assistantpb.UnimplementedAssistantServer
+
+	backend    llm.Backend
+	registry   *tools.Registry
+	store      memory.ConversationStore
+	budget     *memory.Budget
+	summarizer *memory.Summarizer
+}
+
+// New returns a Server that drives the chat+tools loop through backend,
+// serves the tools registered in registry, and persists each session's
+// conversation to store, keeping it within budget via summarizer.
+func New(backend llm.Backend, registry *tools.Registry, store memory.ConversationStore, budget *memory.Budget, summarizer *memory.Summarizer) *Server {
+	return &Server{
+		backend:    backend,
+		registry:   registry,
+		store:      store,
+		budget:     budget,
+		summarizer: summarizer,
+	}
+}
+
+// ListTools reports the server's tool catalog so clients can introspect it.
+func (s *Server) ListTools(ctx context.Context, _ *assistantpb.ListToolsRequest) (*assistantpb.ListToolsResponse, error) {
+	resp := &assistantpb.ListToolsResponse{}
+	for _, p := range s.registry.List() {
+		resp.Tools = append(resp.Tools, &assistantpb.ToolDescriptor{
+			Name:                 p.Name(),
+			Description:          p.Description(),
+			ParametersJsonSchema: string(p.ParametersSchema()),
+		})
+	}
+	return resp, nil
+}
+
+// Ask runs req.Inquiry through the model, streaming events to stream until
+// the turn (including any tool round-trips) completes. Conversation
+// history is keyed by req.SessionId, so later Ask calls with the same
+// session continue the same conversation.
+func (s *Server) Ask(req *assistantpb.AskRequest, stream assistantpb.Assistant_AskServer) error {
+	ctx := stream.Context()
+
+	sessionID := req.SessionId
+	if sessionID == "" {
+		sessionID = defaultSessionID
+	}
+
+	history, err := s.store.Load(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load conversation: %v", err)
+	}
+	if len(history) == 0 {
+		history = []llm.Message{
+			{
+				Role:    "system",
+				Content: "Only use the functions you have been provided with.",
+			},
+			{
+				Role:    "system",
+				Content: "Only answer in 50 words or less.",
+			},
+		}
+	}
+	history = append(history, llm.Message{Role: "user", Content: req.Inquiry})
+
+	return s.converse(ctx, sessionID, stream, history, 0)
+}
+
+func (s *Server) toolDefinitions() []llm.ToolDefinition {
+	var defs []llm.ToolDefinition
+	for _, p := range s.registry.List() {
+		defs = append(defs, llm.ToolDefinition{
+			Name:        p.Name(),
+			Description: p.Description(),
+			Parameters:  p.ParametersSchema(),
+		})
+	}
+	return defs
+}
+
+// converse drives one chat turn through s.backend, streaming token and
+// tool-call events to stream, and recurses for each tool round-trip up to
+// maxTurns deep so a model that never stops requesting tools can't
+// recurse forever.
+func (s *Server) converse(ctx context.Context, sessionID string, stream assistantpb.Assistant_AskServer, messages []llm.Message, turn int) error {
+	if turn >= maxTurns {
+		return fmt.Errorf("exceeded max turns (%d) chasing tool calls", maxTurns)
+	}
+
+	messages, err := memory.Condense(ctx, s.budget, s.summarizer, messages)
+	if err != nil {
+		return fmt.Errorf("failed to condense conversation: %v", err)
+	}
+
+	chunks, chunkErrs := s.backend.ChatStream(ctx, llm.Request{Messages: messages, Tools: s.toolDefinitions()})
+
+	var content strings.Builder
+	var toolCalls []llm.ToolCall
+
+	for chunks != nil || chunkErrs != nil {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				chunks = nil
+				continue
+			}
+			if chunk.Content != "" {
+				content.WriteString(chunk.Content)
+				if err := stream.Send(&assistantpb.AskEvent{
+					Event: &assistantpb.AskEvent_TokenDelta{TokenDelta: &assistantpb.TokenDelta{Content: chunk.Content}},
+				}); err != nil {
+					return err
+				}
+			}
+			if chunk.Done {
+				toolCalls = chunk.ToolCalls
+			}
+		case err, ok := <-chunkErrs:
+			if !ok {
+				chunkErrs = nil
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed chat stream: %v", err)
+			}
+		}
+	}
+
+	if len(toolCalls) == 0 {
+		final := append([]llm.Message{}, messages...)
+		final = append(final, llm.Message{Role: "assistant", Content: content.String()})
+		if err := s.store.Save(ctx, sessionID, final); err != nil {
+			return fmt.Errorf("failed to persist conversation: %v", err)
+		}
+
+		return stream.Send(&assistantpb.AskEvent{
+			Event: &assistantpb.AskEvent_FinalMessage{FinalMessage: &assistantpb.FinalMessage{Content: content.String()}},
+		})
+	}
+
+	var results []llm.Message
+	for _, tc := range toolCalls {
+		if err := stream.Send(&assistantpb.AskEvent{
+			Event: &assistantpb.AskEvent_ToolCallStarted{ToolCallStarted: &assistantpb.ToolCallStarted{
+				Name:     tc.Name,
+				ArgsJson: tc.Arguments,
+			}},
+		}); err != nil {
+			return err
+		}
+
+		args := map[string]interface{}{}
+		_ = json.Unmarshal([]byte(tc.Arguments), &args)
+
+		payload, err := s.registry.Invoke(ctx, tc.Name, args)
+		if err != nil {
+			payload = []byte(fmt.Sprintf("error: %v", err))
+		}
+
+		if err := stream.Send(&assistantpb.AskEvent{
+			Event: &assistantpb.AskEvent_ToolCallResult{ToolCallResult: &assistantpb.ToolCallResult{
+				Name:        tc.Name,
+				PayloadJson: string(payload),
+			}},
+		}); err != nil {
+			return err
+		}
+
+		results = append(results, llm.Message{Role: "tool", Name: tc.Name, Content: string(payload), ToolCallID: tc.ID})
+	}
+
+	newMessages := append([]llm.Message{}, messages...)
+	newMessages = append(newMessages, llm.Message{Role: "assistant", Content: content.String(), ToolCalls: toolCalls})
+	newMessages = append(newMessages, results...)
+
+	if err := s.store.Save(ctx, sessionID, newMessages); err != nil {
+		return fmt.Errorf("failed to persist conversation: %v", err)
+	}
+
+	return s.converse(ctx, sessionID, stream, newMessages, turn+1)
+}
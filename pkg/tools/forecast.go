@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ForecastTool fetches a 5-day/3-hour-step forecast from OpenWeatherMap
+// and condenses it into one line per day.
+type ForecastTool struct {
+	apiKey string
+}
+
+// NewForecastTool returns a ForecastTool that authenticates with apiKey.
+func NewForecastTool(apiKey string) *ForecastTool {
+	return &ForecastTool{apiKey: apiKey}
+}
+
+func (t *ForecastTool) Name() string { return "weather_forecast" }
+
+func (t *ForecastTool) Description() string {
+	return "Get the 5-day weather forecast for a given location"
+}
+
+func (t *ForecastTool) ParametersSchema() json.RawMessage {
+	return weatherParametersSchema
+}
+
+// forecastAPIResponse is the subset of OpenWeatherMap's 5-day forecast
+// response (https://openweathermap.org/forecast5#parameter) we care about.
+type forecastAPIResponse struct {
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			Temp float64 `json:"temp"`
+		} `json:"main"`
+		Weather []struct {
+			Description string `json:"description"`
+		} `json:"weather"`
+	} `json:"list"`
+	City struct {
+		Name string `json:"name"`
+	} `json:"city"`
+}
+
+func (t *ForecastTool) Invoke(ctx context.Context, args map[string]interface{}) ([]byte, error) {
+	lat, ok := args["latitude"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("latitude must be a number")
+	}
+	lon, ok := args["longitude"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("longitude must be a number")
+	}
+
+	units, _ := args["units"].(string)
+	if units == "" {
+		units = "metric"
+	}
+	lang, _ := args["lang"].(string)
+
+	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/forecast?lat=%f&lon=%f&units=%s&appid=%s", lat, lon, units, t.apiKey)
+	if lang != "" {
+		url += "&lang=" + lang
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed forecastAPIResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse forecast response: %v", err)
+	}
+
+	return []byte(summarizeForecast(parsed, units)), nil
+}
+
+// summarizeForecast picks one entry per day (the API reports in 3-hour
+// steps) so the summary stays short enough to hand to the model.
+func summarizeForecast(f forecastAPIResponse, units string) string {
+	var lines []string
+	for i, item := range f.List {
+		if i%8 != 0 { // one entry per 24h (8 * 3h steps)
+			continue
+		}
+
+		celsius, fahrenheit := toCelsiusFahrenheit(item.Main.Temp, units)
+
+		var conditions string
+		if len(item.Weather) > 0 {
+			conditions = item.Weather[0].Description
+		}
+
+		day := time.Unix(item.Dt, 0).UTC().Format("Mon Jan 2")
+		lines = append(lines, fmt.Sprintf("%s: %.1f°C (%.1f°F), %s", day, celsius, fahrenheit, conditions))
+	}
+
+	return fmt.Sprintf("%s forecast:\n%s", f.City.Name, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// GeocodeTool resolves a place name to a latitude/longitude via the
+// Open-Meteo geocoding API.
+type GeocodeTool struct{}
+
+// NewGeocodeTool returns a ready-to-register GeocodeTool.
+func NewGeocodeTool() *GeocodeTool {
+	return &GeocodeTool{}
+}
+
+func (t *GeocodeTool) Name() string { return "geocode" }
+
+func (t *GeocodeTool) Description() string {
+	return "Get the latitude and longitude of a location"
+}
+
+func (t *GeocodeTool) ParametersSchema() json.RawMessage {
+	return json.RawMessage([]byte(`{
+		"type": "object",
+		"required": [
+			"location"
+		],
+		"properties": {
+			"location": {
+				"type": "string",
+				"description": "The city, e.g. New York"
+			}
+		}
+	}`))
+}
+
+func (t *GeocodeTool) Invoke(ctx context.Context, args map[string]interface{}) ([]byte, error) {
+	location, _ := args["location"].(string)
+
+	url := fmt.Sprintf("https://geocoding-api.open-meteo.com/v1/search?name=%s&count=1&format=json", location)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
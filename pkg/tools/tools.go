@@ -0,0 +1,116 @@
+// Package tools holds the assistant's callable tools behind a small
+// registry so servers (CLI, gRPC) can share one catalog instead of each
+// hardcoding its own switch statement.
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// ToolProvider is a single callable tool: its OpenAI function definition
+// plus the code that actually runs it.
+type ToolProvider interface {
+	Name() string
+	Description() string
+	ParametersSchema() json.RawMessage
+	Invoke(ctx context.Context, args map[string]interface{}) ([]byte, error)
+}
+
+// registration pairs a provider with its compiled JSON Schema so Invoke
+// can validate arguments before running it.
+type registration struct {
+	provider ToolProvider
+	schema   *jsonschema.Schema
+}
+
+// Registry is a thread-safe catalog of ToolProviders, keyed by name.
+type Registry struct {
+	mu           sync.RWMutex
+	registration map[string]registration
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{registration: make(map[string]registration)}
+}
+
+// Register compiles p's JSON-Schema parameters and adds p to the catalog,
+// keyed by p.Name(). It fails if the schema doesn't compile.
+func (r *Registry) Register(p ToolProvider) error {
+	schema, err := compileSchema(p.Name(), p.ParametersSchema())
+	if err != nil {
+		return fmt.Errorf("tools: failed to compile schema for %q: %v", p.Name(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.registration[p.Name()] = registration{provider: p, schema: schema}
+	return nil
+}
+
+func compileSchema(name string, raw json.RawMessage) (*jsonschema.Schema, error) {
+	resourceName := name + ".json"
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(resourceName, strings.NewReader(string(raw))); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(resourceName)
+}
+
+// Get looks up a provider by name.
+func (r *Registry) Get(name string) (ToolProvider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	reg, ok := r.registration[name]
+	return reg.provider, ok
+}
+
+// List returns every registered provider, in no particular order.
+func (r *Registry) List() []ToolProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]ToolProvider, 0, len(r.registration))
+	for _, reg := range r.registration {
+		out = append(out, reg.provider)
+	}
+	return out
+}
+
+// ValidationError reports that the model's arguments for a tool call
+// don't match the tool's declared JSON Schema. Its message is meant to be
+// sent straight back to the model as the tool result, so it can
+// self-correct instead of the program crashing on a bad type assertion.
+type ValidationError struct {
+	Tool string
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid arguments for tool %q: %v", e.Tool, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Invoke looks up name in the registry, validates args against its JSON
+// Schema, and runs it. It returns a *ValidationError if args don't match
+// the schema, or an error if no such tool is registered.
+func (r *Registry) Invoke(ctx context.Context, name string, args map[string]interface{}) ([]byte, error) {
+	r.mu.RLock()
+	reg, ok := r.registration[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown tool %q", name)
+	}
+
+	if err := reg.schema.Validate(args); err != nil {
+		return nil, &ValidationError{Tool: name, Err: err}
+	}
+
+	return reg.provider.Invoke(ctx, args)
+}
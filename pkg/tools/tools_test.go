@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// stubProvider is a minimal ToolProvider for exercising Registry without
+// hitting a real API.
+type stubProvider struct {
+	name   string
+	schema json.RawMessage
+	invoke func(ctx context.Context, args map[string]interface{}) ([]byte, error)
+}
+
+func (p *stubProvider) Name() string                      { return p.name }
+func (p *stubProvider) Description() string               { return "stub tool for tests" }
+func (p *stubProvider) ParametersSchema() json.RawMessage { return p.schema }
+func (p *stubProvider) Invoke(ctx context.Context, args map[string]interface{}) ([]byte, error) {
+	return p.invoke(ctx, args)
+}
+
+var stubSchema = json.RawMessage(`{
+	"type": "object",
+	"required": ["latitude"],
+	"properties": {
+		"latitude": {"type": "number"}
+	}
+}`)
+
+func newStubRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	p := &stubProvider{
+		name:   "weather",
+		schema: stubSchema,
+		invoke: func(ctx context.Context, args map[string]interface{}) ([]byte, error) {
+			return []byte("ok"), nil
+		},
+	}
+
+	r := NewRegistry()
+	if err := r.Register(p); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	return r
+}
+
+func TestRegistryInvoke_RejectsMissingRequiredField(t *testing.T) {
+	r := newStubRegistry(t)
+
+	_, err := r.Invoke(context.Background(), "weather", map[string]interface{}{})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Invoke error = %v (%T), want *ValidationError", err, err)
+	}
+	if verr.Tool != "weather" {
+		t.Errorf("ValidationError.Tool = %q, want %q", verr.Tool, "weather")
+	}
+}
+
+func TestRegistryInvoke_RejectsWrongType(t *testing.T) {
+	r := newStubRegistry(t)
+
+	// The model returning a string where the schema declares a number must
+	// be rejected here, before the provider's type assertion ever sees it.
+	_, err := r.Invoke(context.Background(), "weather", map[string]interface{}{"latitude": "51.5"})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Invoke error = %v (%T), want *ValidationError", err, err)
+	}
+}
+
+func TestRegistryInvoke_ValidArgsReachTheProvider(t *testing.T) {
+	r := newStubRegistry(t)
+
+	out, err := r.Invoke(context.Background(), "weather", map[string]interface{}{"latitude": 51.5})
+	if err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if string(out) != "ok" {
+		t.Errorf("Invoke output = %q, want %q", out, "ok")
+	}
+}
+
+func TestRegistryInvoke_UnknownTool(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Invoke(context.Background(), "nonexistent", nil)
+	if err == nil {
+		t.Fatal("Invoke with unknown tool name: want error, got nil")
+	}
+
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		t.Errorf("Invoke with unknown tool name returned a *ValidationError, want a plain lookup error")
+	}
+}
+
+func TestValidationError_MessageIsSuitableForFeedingBackToTheModel(t *testing.T) {
+	err := &ValidationError{Tool: "weather", Err: errors.New("missing property 'latitude'")}
+
+	got := err.Error()
+	want := `invalid arguments for tool "weather": missing property 'latitude'`
+	if got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, err) {
+		t.Errorf("ValidationError should satisfy errors.Is against itself")
+	}
+	if errors.Unwrap(err) == nil {
+		t.Errorf("Unwrap() = nil, want the wrapped schema error")
+	}
+}
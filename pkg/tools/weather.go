@@ -0,0 +1,155 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// WeatherTool fetches the current weather for a coordinate from
+// OpenWeatherMap.
+type WeatherTool struct {
+	apiKey string
+}
+
+// NewWeatherTool returns a WeatherTool that authenticates with apiKey.
+func NewWeatherTool(apiKey string) *WeatherTool {
+	return &WeatherTool{apiKey: apiKey}
+}
+
+func (t *WeatherTool) Name() string { return "weather" }
+
+func (t *WeatherTool) Description() string {
+	return "Get the current weather in a given location"
+}
+
+func (t *WeatherTool) ParametersSchema() json.RawMessage {
+	return weatherParametersSchema
+}
+
+var weatherParametersSchema = json.RawMessage([]byte(`{
+	"type": "object",
+	"required": [
+		"latitude",
+		"longitude"
+	],
+	"properties": {
+		"latitude": {
+			"type": "number",
+			"description": "The latitude"
+		},
+		"longitude": {
+			"type": "number",
+			"description": "The longitude"
+		},
+		"units": {
+			"type": "string",
+			"enum": ["metric", "imperial", "standard"],
+			"description": "Unit system for the raw OpenWeatherMap response; the summary always reports both °C and °F regardless"
+		},
+		"lang": {
+			"type": "string",
+			"description": "Language for the weather condition text, e.g. 'en', 'id'"
+		}
+	}
+}`))
+
+// weatherAPIResponse is the subset of OpenWeatherMap's current weather
+// response (https://openweathermap.org/current#parameter) we care about.
+type weatherAPIResponse struct {
+	Weather []struct {
+		Main        string `json:"main"`
+		Description string `json:"description"`
+	} `json:"weather"`
+	Main struct {
+		Temp     float64 `json:"temp"`
+		Humidity float64 `json:"humidity"`
+		Pressure float64 `json:"pressure"`
+	} `json:"main"`
+	Wind struct {
+		Speed float64 `json:"speed"`
+	} `json:"wind"`
+	Visibility float64 `json:"visibility"`
+	Name       string  `json:"name"`
+}
+
+// toCelsiusFahrenheit normalizes temp (reported in the given OpenWeatherMap
+// units system) to both °C and °F.
+func toCelsiusFahrenheit(temp float64, units string) (celsius, fahrenheit float64) {
+	switch units {
+	case "imperial":
+		fahrenheit = temp
+		celsius = (fahrenheit - 32) * 5 / 9
+	case "standard":
+		celsius = temp - 273.15
+		fahrenheit = celsius*9/5 + 32
+	default: // metric
+		celsius = temp
+		fahrenheit = celsius*9/5 + 32
+	}
+	return celsius, fahrenheit
+}
+
+func (t *WeatherTool) Invoke(ctx context.Context, args map[string]interface{}) ([]byte, error) {
+	// latitude/longitude are declared as JSON-Schema "number" in
+	// ParametersSchema, so they arrive as float64, not string.
+	lat, ok := args["latitude"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("latitude must be a number")
+	}
+	lon, ok := args["longitude"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("longitude must be a number")
+	}
+
+	units, _ := args["units"].(string)
+	if units == "" {
+		units = "metric"
+	}
+	lang, _ := args["lang"].(string)
+
+	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/weather?lat=%f&lon=%f&units=%s&appid=%s", lat, lon, units, t.apiKey)
+	if lang != "" {
+		url += "&lang=" + lang
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed weatherAPIResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse weather response: %v", err)
+	}
+
+	return []byte(summarizeWeather(parsed, units)), nil
+}
+
+func summarizeWeather(w weatherAPIResponse, units string) string {
+	celsius, fahrenheit := toCelsiusFahrenheit(w.Main.Temp, units)
+
+	conditions := make([]string, 0, len(w.Weather))
+	for _, c := range w.Weather {
+		conditions = append(conditions, c.Description)
+	}
+
+	return fmt.Sprintf(
+		"%s: %.1f°C (%.1f°F), %s, humidity %.0f%%, wind %.1f m/s",
+		w.Name, celsius, fahrenheit, strings.Join(conditions, ", "), w.Main.Humidity, w.Wind.Speed,
+	)
+}